@@ -0,0 +1,130 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Command cla-resync runs the cla plugin's periodic cncf-cla:* label resync loop as a
+// standalone singleton, so drifted labels (missed webhooks, GitHub App reinstalls) get caught
+// even when nothing retriggers the webhook-driven path in cmd/hook.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"sigs.k8s.io/yaml"
+
+	"sigs.k8s.io/prow/pkg/config"
+	"sigs.k8s.io/prow/pkg/flagutil"
+	"sigs.k8s.io/prow/pkg/interrupts"
+	"sigs.k8s.io/prow/pkg/logrusutil"
+	"sigs.k8s.io/prow/pkg/plugins"
+	"sigs.k8s.io/prow/pkg/plugins/cla"
+)
+
+type options struct {
+	pluginConfigPath string
+	orgRepos         flagutil.Strings
+	interval         time.Duration
+	jitter           time.Duration
+	workers          int
+	github           flagutil.GitHubOptions
+}
+
+func gatherOptions() options {
+	o := options{}
+	flag.StringVar(&o.pluginConfigPath, "plugin-config", "", "Path to the plugin config file containing the CLA stanza.")
+	flag.Var(&o.orgRepos, "org-repo", "org/repo whose open PRs should be resynced; may be repeated.")
+	flag.DurationVar(&o.interval, "resync-interval", 30*time.Minute, "How often to resync each repo's labels.")
+	flag.DurationVar(&o.jitter, "resync-jitter", time.Minute, "Random delay added before each pass, to stagger replicas.")
+	flag.IntVar(&o.workers, "resync-workers", 4, "Max PRs reconciled concurrently per repo.")
+	o.github.AddFlags(flag.CommandLine)
+	flag.Parse()
+	return o
+}
+
+func (o options) validate() error {
+	if o.pluginConfigPath == "" {
+		return fmt.Errorf("--plugin-config must be set")
+	}
+	if len(o.orgRepos.Strings()) == 0 {
+		return fmt.Errorf("at least one --org-repo must be set")
+	}
+	return o.github.Validate(false)
+}
+
+func parseOrgRepos(raw []string) ([]config.OrgRepo, error) {
+	var out []config.OrgRepo
+	for _, s := range raw {
+		parts := strings.SplitN(s, "/", 2)
+		if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+			return nil, fmt.Errorf("invalid --org-repo %q, want org/repo", s)
+		}
+		out = append(out, config.OrgRepo{Org: parts[0], Repo: parts[1]})
+	}
+	return out, nil
+}
+
+func loadPluginConfig(path string) (*plugins.Configuration, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("could not read plugin config: %w", err)
+	}
+	var pc plugins.Configuration
+	if err := yaml.Unmarshal(raw, &pc); err != nil {
+		return nil, fmt.Errorf("could not parse plugin config: %w", err)
+	}
+	return &pc, nil
+}
+
+func main() {
+	o := gatherOptions()
+	if err := o.validate(); err != nil {
+		logrus.WithError(err).Fatal("Invalid options.")
+	}
+
+	logrusutil.ComponentInit()
+	log := logrus.StandardLogger().WithField("component", "cla-resync")
+
+	orgRepos, err := parseOrgRepos(o.orgRepos.Strings())
+	if err != nil {
+		log.WithError(err).Fatal("Invalid --org-repo.")
+	}
+
+	pc, err := loadPluginConfig(o.pluginConfigPath)
+	if err != nil {
+		log.WithError(err).Fatal("Could not load plugin config.")
+	}
+
+	githubClient, err := o.github.GitHubClient(false)
+	if err != nil {
+		log.WithError(err).Fatal("Could not construct GitHub client.")
+	}
+
+	resyncer := cla.NewResyncer(githubClient, log, pc, cla.ResyncConfig{
+		OrgRepos: orgRepos,
+		Interval: o.interval,
+		Jitter:   o.jitter,
+		Workers:  o.workers,
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	interrupts.OnInterrupt(cancel)
+	resyncer.Run(ctx)
+}
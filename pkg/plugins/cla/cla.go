@@ -17,7 +17,11 @@ limitations under the License.
 package cla
 
 import (
+	"bytes"
 	"fmt"
+	"sort"
+	"strings"
+	"text/template"
 	"time"
 
 	"github.com/sirupsen/logrus"
@@ -38,19 +42,200 @@ const (
 )
 
 var (
-	checkCLARe = regexp.MustCompile(`(?mi)^/check-cla\s*$`)
+	checkCLARe  = regexp.MustCompile(`(?mi)^/check-cla\s*$`)
+	claReportRe = regexp.MustCompile(`(?mi)^/cla-report\s*$`)
 )
 
+// defaultNotSignedComment is the guidance comment posted on a PR when it transitions to
+// cncf-cla:no. It is rendered with text/template; see Config.CommentTemplate.
+const defaultNotSignedComment = `Hi @{{.Author}}, thanks for your pull request!
+
+It looks like the CLA for {{.Org}}/{{.Repo}} hasn't been signed yet, so we can't accept this
+contribution until it is.
+{{if .SignURL}}
+Please sign the CLA at {{.SignURL}}, then comment ` + "`/check-cla`" + ` to re-trigger this check.
+{{else}}
+Please sign the CLA, then comment ` + "`/check-cla`" + ` to re-trigger this check.
+{{end}}
+Once the CLA is signed, the ` + "`cncf-cla:no`" + ` label will be removed automatically.`
+
+// claCommentData is the data made available to Config.CommentTemplate.
+type claCommentData struct {
+	Author  string
+	SignURL string
+	Org     string
+	Repo    string
+}
+
+// render executes the configured (or default) comment template for the given PR author.
+func (c Config) render(org, repo, author string) (string, error) {
+	tmplText := c.CommentTemplate
+	if tmplText == "" {
+		tmplText = defaultNotSignedComment
+	}
+	tmpl, err := template.New("cla-not-signed").Parse(tmplText)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse CLA comment template: %w", err)
+	}
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, claCommentData{Author: author, SignURL: c.SignURL, Org: org, Repo: repo}); err != nil {
+		return "", fmt.Errorf("failed to render CLA comment template: %w", err)
+	}
+	return buf.String(), nil
+}
+
+// notSignedMarker returns a hidden marker embedded in the CLA-not-signed comment so we can
+// detect whether we've already notified the author for this HEAD SHA.
+func notSignedMarker(sha string) string {
+	return fmt.Sprintf("<!-- cla-not-signed-sha:%s -->", sha)
+}
+
+// postNotSignedComment posts the CLA-not-signed guidance comment, skipping it if the bot has
+// already commented for this HEAD SHA or if the org/repo has opted out.
+func postNotSignedComment(gc gitHubClient, log *logrus.Entry, claCfg Config, org, repo string, number int, author, sha string) {
+	if claCfg.SuppressCommentOnLabelChange {
+		return
+	}
+
+	marker := notSignedMarker(sha)
+	comments, err := gc.ListIssueComments(org, repo, number)
+	if err != nil {
+		log.WithError(err).Warning("Could not list comments to dedup CLA-not-signed comment.")
+	} else {
+		for _, comment := range comments {
+			if strings.Contains(comment.Body, marker) {
+				return
+			}
+		}
+	}
+
+	msg, err := claCfg.render(org, repo, author)
+	if err != nil {
+		log.WithError(err).Warning("Could not render CLA-not-signed comment.")
+		return
+	}
+	if err := gc.CreateComment(org, repo, number, msg+"\n"+marker); err != nil {
+		log.WithError(err).Warning("Could not post CLA-not-signed comment.")
+	}
+}
+
+// reconcileLabels applies the cncf-cla:yes/no label transition implied by status for a PR,
+// posting the CLA-not-signed comment on a transition into cncf-cla:no. It is shared by the
+// webhook-driven handle/handleComment paths and the periodic resync loop so they can never
+// drift out of sync with each other.
+func reconcileLabels(gc gitHubClient, log *logrus.Entry, claCfg Config, org, repo string, number int, author, sha string, hasYes, hasNo bool, state string) error {
+	switch {
+	case claCfg.isSuccess(state):
+		if hasNo {
+			if err := gc.RemoveLabel(org, repo, number, labels.ClaNo); err != nil {
+				return fmt.Errorf("could not remove %s label: %w", labels.ClaNo, err)
+			}
+		}
+		if !hasYes {
+			if err := gc.AddLabel(org, repo, number, labels.ClaYes); err != nil {
+				return fmt.Errorf("could not add %s label: %w", labels.ClaYes, err)
+			}
+		}
+	case claCfg.isFailure(state):
+		if hasYes {
+			if err := gc.RemoveLabel(org, repo, number, labels.ClaYes); err != nil {
+				return fmt.Errorf("could not remove %s label: %w", labels.ClaYes, err)
+			}
+		}
+		if !hasNo {
+			if err := gc.AddLabel(org, repo, number, labels.ClaNo); err != nil {
+				return fmt.Errorf("could not add %s label: %w", labels.ClaNo, err)
+			}
+			postNotSignedComment(gc, log, claCfg, org, repo, number, author, sha)
+		}
+	}
+	return nil
+}
+
+// Config wraps a plugins.CLA entry with the cla plugin's matching and rendering behavior. It
+// allows repositories that don't use EasyCLA (e.g. cla/linuxfoundation, cla/google, a DCO bot,
+// or an in-house CLA service) to plug into the same label-management behavior.
+type Config struct {
+	plugins.CLA
+}
+
+// usesCLA reports whether this entry requires the CLA-status-driven labels.
+func (c Config) usesCLA() bool {
+	return c.Mode == "" || c.Mode == "cla" || c.Mode == "cla-or-dco"
+}
+
+// usesDCO reports whether this entry requires the DCO sign-off check.
+func (c Config) usesDCO() bool {
+	return c.Mode == "dco" || c.Mode == "cla-or-dco"
+}
+
+func (c Config) contextName() string {
+	if c.ContextName != "" {
+		return c.ContextName
+	}
+	return claContextName
+}
+
+func (c Config) isSuccess(state string) bool {
+	if len(c.SuccessStates) == 0 {
+		return state == github.StatusSuccess
+	}
+	for _, s := range c.SuccessStates {
+		if s == state {
+			return true
+		}
+	}
+	return false
+}
+
+func (c Config) isFailure(state string) bool {
+	if len(c.FailureStates) == 0 {
+		return state == github.StatusFailure || state == github.StatusError
+	}
+	for _, s := range c.FailureStates {
+		if s == state {
+			return true
+		}
+	}
+	return false
+}
+
+// claConfigFor returns the CLA config that applies to org/repo, preferring a Repos match over
+// an Orgs match. It falls back to the EasyCLA default so deployments with no CLA stanza keep
+// working unchanged.
+func claConfigFor(pc *plugins.Configuration, org, repo string) Config {
+	fullName := org + "/" + repo
+	for _, cfg := range pc.CLA {
+		for _, r := range cfg.Repos {
+			if r == fullName {
+				return Config{cfg}
+			}
+		}
+	}
+	for _, cfg := range pc.CLA {
+		for _, o := range cfg.Orgs {
+			if o == org {
+				return Config{cfg}
+			}
+		}
+	}
+	return Config{plugins.CLA{ContextName: claContextName}}
+}
+
 func init() {
 	plugins.RegisterStatusEventHandler(pluginName, handleStatusEvent, helpProvider)
 	plugins.RegisterGenericCommentHandler(pluginName, handleCommentEvent, helpProvider)
 }
 
 func helpProvider(config *plugins.Configuration, _ []config.OrgRepo) (*pluginhelp.PluginHelp, error) {
-	// The {WhoCanUse, Usage, Examples, Config} fields are omitted because this plugin cannot be
-	// manually triggered and is not configurable.
+	// The {WhoCanUse, Usage, Examples} fields are omitted here; the manually triggered
+	// commands are described via AddCommand below. Config is included since the CLA context
+	// name is configurable.
 	pluginHelp := &pluginhelp.PluginHelp{
-		Description: "The cla plugin manages the application and removal of the 'cncf-cla' prefixed labels on pull requests as a reaction to the " + claContextName + " github status context. It is also responsible for warning unauthorized PR authors that they need to sign the CNCF CLA before their PR will be merged.",
+		Description: "The cla plugin manages the application and removal of the 'cncf-cla' prefixed labels on pull requests as a reaction to a configured CLA provider's github status context (" + claContextName + " by default). It is also responsible for warning unauthorized PR authors that they need to sign the CLA before their PR will be merged.",
+		Config: map[string]string{
+			"": fmt.Sprintf("The cla plugin uses the %s github status context by default. Orgs/repos may override this via the CLA config stanza to use a different CLA provider.", claContextName),
+		},
 	}
 	pluginHelp.AddCommand(pluginhelp.Command{
 		Usage:       "/check-cla",
@@ -59,6 +244,13 @@ func helpProvider(config *plugins.Configuration, _ []config.OrgRepo) (*pluginhel
 		WhoCanUse:   "Anyone",
 		Examples:    []string{"/check-cla"},
 	})
+	pluginHelp.AddCommand(pluginhelp.Command{
+		Usage:       "/cla-report",
+		Description: "Posts a table of open PRs that are missing a CLA signature, grouped by author.",
+		Featured:    false,
+		WhoCanUse:   "Org members",
+		Examples:    []string{"/cla-report"},
+	})
 	return pluginHelp, nil
 }
 
@@ -69,34 +261,43 @@ type gitHubClient interface {
 	FindIssues(query, sort string, asc bool) ([]github.Issue, error)
 	GetIssueLabels(org, repo string, number int) ([]github.Label, error)
 	GetCombinedStatus(org, repo, ref string) (*github.CombinedStatus, error)
+	CreateComment(owner, repo string, number int, comment string) error
+	ListIssueComments(owner, repo string, number int) ([]github.IssueComment, error)
+	IsMember(org, user string) (bool, error)
+	ListPullRequestCommits(org, repo string, number int) ([]github.RepositoryCommit, error)
 }
 
 func handleStatusEvent(pc plugins.Agent, se github.StatusEvent) error {
-	return handle(pc.GitHubClient, pc.Logger, se)
+	return handle(pc.GitHubClient, pc.Logger, pc.PluginConfig, se)
 }
 
-//  1. Check that the status event received from the webhook is for the CNCF-CLA.
+//  1. Check that the status event received from the webhook is for the configured CLA provider, or (in "dco" and
+//     "cla-or-dco" mode) one that should trigger DCO sign-off reconciliation.
 //  2. Use the github search API to search for the PRs which match the commit hash corresponding to the status event.
 //  3. For each issue that matches, check that the PR's HEAD commit hash against the commit hash for which the status
 //     was received. This is because we only care about the status associated with the last (latest) commit in a PR.
 //  4. Set the corresponding CLA label if needed.
-func handle(gc gitHubClient, log *logrus.Entry, se github.StatusEvent) error {
+func handle(gc gitHubClient, log *logrus.Entry, pc *plugins.Configuration, se github.StatusEvent) error {
 	if se.State == "" || se.Context == "" {
 		return fmt.Errorf("invalid status event delivered with empty state/context")
 	}
 
-	if se.Context != claContextName {
-		// Not the CNCF CLA context, do not process this.
-		return nil
-	}
-
-	if se.State == github.StatusPending {
-		// do nothing and wait for state to be updated.
+	org := se.Repo.Owner.Login
+	repo := se.Repo.Name
+	claCfg := claConfigFor(pc, org, repo)
+
+	// claRelevant and dcoRelevant are computed independently (rather than as an if/else-if on
+	// usesCLA/usesDCO) so that in "cla-or-dco" mode, a status event for either signal is acted
+	// on: a DCO-check status update must not be dropped just because its context isn't the CLA
+	// context, and a CLA status update must not be skipped just because the CLA label already
+	// matches it when the DCO label still needs reconciling.
+	claRelevant := claCfg.usesCLA() && se.Context == claCfg.contextName() && (claCfg.isSuccess(se.State) || claCfg.isFailure(se.State))
+	dcoRelevant := claCfg.usesDCO() && se.State != github.StatusPending && (claCfg.DCOContextName == "" || se.Context == claCfg.DCOContextName)
+	if !claRelevant && !dcoRelevant {
+		// Not a context/state this org/repo's CLA or DCO config cares about.
 		return nil
 	}
 
-	org := se.Repo.Owner.Login
-	repo := se.Repo.Name
 	log.Info("Searching for PRs matching the commit.")
 
 	var issues []github.Issue
@@ -117,16 +318,20 @@ func handle(gc gitHubClient, log *logrus.Entry, se github.StatusEvent) error {
 		l := log.WithField("pr", issue.Number)
 		hasCncfYes := issue.HasLabel(labels.ClaYes)
 		hasCncfNo := issue.HasLabel(labels.ClaNo)
-		if hasCncfYes && se.State == github.StatusSuccess {
-			// Nothing to update.
-			l.Infof("PR has up-to-date %s label.", labels.ClaYes)
-			continue
-		}
+		// Only short-circuit on an up-to-date CLA label when CLA is the sole relevant signal;
+		// if DCO also needs reconciling for this event, fall through so reconcileDCO still runs.
+		if claRelevant && !dcoRelevant {
+			if hasCncfYes && claCfg.isSuccess(se.State) {
+				// Nothing to update.
+				l.Infof("PR has up-to-date %s label.", labels.ClaYes)
+				continue
+			}
 
-		if hasCncfNo && (se.State == github.StatusFailure || se.State == github.StatusError) {
-			// Nothing to update.
-			l.Infof("PR has up-to-date %s label.", labels.ClaNo)
-			continue
+			if hasCncfNo && claCfg.isFailure(se.State) {
+				// Nothing to update.
+				l.Infof("PR has up-to-date %s label.", labels.ClaNo)
+				continue
+			}
 		}
 
 		l.Info("PR labels may be out of date. Getting pull request info.")
@@ -142,48 +347,43 @@ func handle(gc gitHubClient, log *logrus.Entry, se github.StatusEvent) error {
 			continue
 		}
 
-		number := pr.Number
-		if se.State == github.StatusSuccess {
-			if hasCncfNo {
-				if err := gc.RemoveLabel(org, repo, number, labels.ClaNo); err != nil {
-					l.WithError(err).Warningf("Could not remove %s label.", labels.ClaNo)
-				}
-			}
-			if err := gc.AddLabel(org, repo, number, labels.ClaYes); err != nil {
-				l.WithError(err).Warningf("Could not add %s label.", labels.ClaYes)
+		if claRelevant {
+			if err := reconcileLabels(gc, l, claCfg, org, repo, pr.Number, pr.User.Login, pr.Head.SHA, hasCncfYes, hasCncfNo, se.State); err != nil {
+				l.WithError(err).Warning("Could not reconcile CLA labels.")
 			}
-			continue
 		}
-
-		// If we end up here, the status is a failure/error.
-		if hasCncfYes {
-			if err := gc.RemoveLabel(org, repo, number, labels.ClaYes); err != nil {
-				l.WithError(err).Warningf("Could not remove %s label.", labels.ClaYes)
+		if dcoRelevant {
+			if err := reconcileDCO(gc, l, org, repo, pr.Number, pr.User.Login); err != nil {
+				l.WithError(err).Warning("Could not reconcile DCO sign-off.")
 			}
 		}
-		if err := gc.AddLabel(org, repo, number, labels.ClaNo); err != nil {
-			l.WithError(err).Warningf("Could not add %s label.", labels.ClaNo)
-		}
 	}
 	return nil
 }
 
 func handleCommentEvent(pc plugins.Agent, ce github.GenericCommentEvent) error {
-	return handleComment(pc.GitHubClient, pc.Logger, &ce)
+	return handleComment(pc.GitHubClient, pc.Logger, pc.PluginConfig, &ce)
 }
 
-func handleComment(gc gitHubClient, log *logrus.Entry, e *github.GenericCommentEvent) error {
+func handleComment(gc gitHubClient, log *logrus.Entry, pc *plugins.Configuration, e *github.GenericCommentEvent) error {
 	// Only consider open PRs and new comments.
 	if e.IssueState != "open" || e.Action != github.GenericCommentActionCreated {
 		return nil
 	}
+
+	org := e.Repo.Owner.Login
+	repo := e.Repo.Name
+	claCfg := claConfigFor(pc, org, repo)
+
+	if claReportRe.MatchString(e.Body) {
+		return handleReportComment(gc, log, claCfg, org, repo, e)
+	}
+
 	// Only consider "/check-cla" comments.
 	if !checkCLARe.MatchString(e.Body) {
 		return nil
 	}
 
-	org := e.Repo.Owner.Login
-	repo := e.Repo.Name
 	number := e.Number
 	hasCLAYes := false
 	hasCLANo := false
@@ -205,59 +405,163 @@ func handleComment(gc gitHubClient, log *logrus.Entry, e *github.GenericCommentE
 
 	pr, err := gc.GetPullRequest(org, repo, e.Number)
 	if err != nil {
-		log.WithError(err).Errorf("Unable to fetch PR-%d from %s/%s.", e.Number, org, repo)
+		return fmt.Errorf("unable to fetch PR-%d from %s/%s: %w", e.Number, org, repo, err)
 	}
 
-	// Check for the cla in past commit statuses, and add/remove corresponding cla label if necessary.
 	ref := pr.Head.SHA
-	combined, err := gc.GetCombinedStatus(org, repo, ref)
-	if err != nil {
-		log.WithError(err).Errorf("Failed to get statuses on %s/%s#%d", org, repo, number)
+	if claCfg.usesCLA() {
+		// Check for the cla in past commit statuses, and add/remove corresponding cla label if necessary.
+		combined, err := gc.GetCombinedStatus(org, repo, ref)
+		if err != nil {
+			return fmt.Errorf("failed to get statuses on %s/%s#%d: %w", org, repo, number, err)
+		}
+
+		for _, status := range combined.Statuses {
+
+			// Only consider the context we care about
+			if status.Context == claCfg.contextName() {
+				if err := reconcileLabels(gc, log, claCfg, org, repo, number, pr.User.Login, ref, hasCLAYes, hasCLANo, status.State); err != nil {
+					log.WithError(err).Warning("Could not reconcile CLA labels.")
+				}
+
+				// No need to consider other contexts once you find the one you need.
+				break
+			}
+		}
 	}
 
-	for _, status := range combined.Statuses {
+	if claCfg.usesDCO() {
+		if err := reconcileDCO(gc, log, org, repo, number, pr.User.Login); err != nil {
+			log.WithError(err).Warning("Could not reconcile DCO sign-off.")
+		}
+	}
+	return nil
+}
 
-		// Only consider the context we care about
-		if status.Context == claContextName {
+// searchPageSize is the GitHub search API's results-per-page, used to detect a short (final)
+// page when paginating findUnsignedPRs.
+const searchPageSize = 100
+
+// findUnsignedPRs pages through gc.FindIssues(query, "created", true) past the search API's
+// single-page limit, advancing the query by the newest-seen creation time each round. Results
+// come back oldest-first so later pages only look further forward in time.
+func findUnsignedPRs(gc gitHubClient, query string) ([]github.Issue, error) {
+	var all []github.Issue
+	seen := map[int]bool{}
+	q := query
+	for {
+		page, err := gc.FindIssues(q, "created", true)
+		if err != nil {
+			return nil, err
+		}
 
-			// Success state implies that the cla exists, so label should be cncf-cla:yes.
-			if status.State == github.StatusSuccess {
+		var added int
+		for _, issue := range page {
+			if seen[issue.Number] {
+				continue
+			}
+			seen[issue.Number] = true
+			all = append(all, issue)
+			added++
+		}
+		if len(page) < searchPageSize || added == 0 {
+			break
+		}
 
-				// Remove cncf-cla:no (if label exists).
-				if hasCLANo {
-					if err := gc.RemoveLabel(org, repo, number, labels.ClaNo); err != nil {
-						log.WithError(err).Warningf("Could not remove %s label.", labels.ClaNo)
-					}
-				}
+		newest := page[len(page)-1]
+		q = fmt.Sprintf("%s created:>=%s", query, newest.CreatedAt.UTC().Format(time.RFC3339))
+	}
+	return all, nil
+}
 
-				// Add cncf-cla:yes (if label doesn't exist).
-				if !hasCLAYes {
-					if err := gc.AddLabel(org, repo, number, labels.ClaYes); err != nil {
-						log.WithError(err).Warningf("Could not add %s label.", labels.ClaYes)
-					}
-				}
+// handleReportComment responds to "/cla-report" by posting a Markdown table of open PRs that
+// are missing a CLA signature, grouped by author. Only org members may trigger it.
+func handleReportComment(gc gitHubClient, log *logrus.Entry, claCfg Config, org, repo string, e *github.GenericCommentEvent) error {
+	isMember, err := gc.IsMember(org, e.User.Login)
+	if err != nil {
+		return fmt.Errorf("could not check org membership for %s: %w", e.User.Login, err)
+	}
+	if !isMember {
+		log.Infof("Ignoring /cla-report from non-member %s.", e.User.Login)
+		return nil
+	}
 
-				// Failure state implies that the cla does not exist, so label should be cncf-cla:no.
-			} else if status.State == github.StatusFailure {
+	issues, err := findUnsignedPRs(gc, fmt.Sprintf("is:pr is:open label:%s repo:%s/%s", labels.ClaNo, org, repo))
+	if err != nil {
+		return fmt.Errorf("error searching for unsigned-CLA PRs: %w", err)
+	}
 
-				// Remove cncf-cla:yes (if label exists).
-				if hasCLAYes {
-					if err := gc.RemoveLabel(org, repo, number, labels.ClaYes); err != nil {
-						log.WithError(err).Warningf("Could not remove %s label.", labels.ClaYes)
-					}
-				}
+	if len(issues) == 0 {
+		return gc.CreateComment(org, repo, e.Number, "No open PRs are currently missing a CLA signature.")
+	}
 
-				// Add cncf-cla:no (if label doesn't exist).
-				if !hasCLANo {
-					if err := gc.AddLabel(org, repo, number, labels.ClaNo); err != nil {
-						log.WithError(err).Warningf("Could not add %s label.", labels.ClaNo)
-					}
-				}
-			}
+	var prs []unsignedPR
+	for _, issue := range issues {
+		prs = append(prs, unsignedPRStatusAge(gc, claCfg, org, repo, issue))
+	}
+
+	return gc.CreateComment(org, repo, e.Number, claReportTable(claCfg, prs))
+}
+
+// unsignedPR pairs an unsigned-CLA issue with the timestamp of its last relevant CLA status
+// update, which is what /cla-report's "oldest" ranking is actually about.
+type unsignedPR struct {
+	issue          github.Issue
+	lastStatusTime time.Time
+}
 
-			// No need to consider other contexts once you find the one you need.
+// unsignedPRStatusAge looks up when the configured CLA context last reported on issue's PR HEAD,
+// falling back to the issue's creation time if the PR or its combined status can't be fetched
+// (e.g. the PR was just closed, or the provider hasn't reported yet).
+func unsignedPRStatusAge(gc gitHubClient, claCfg Config, org, repo string, issue github.Issue) unsignedPR {
+	up := unsignedPR{issue: issue, lastStatusTime: issue.CreatedAt}
+
+	pr, err := gc.GetPullRequest(org, repo, issue.Number)
+	if err != nil {
+		return up
+	}
+	combined, err := gc.GetCombinedStatus(org, repo, pr.Head.SHA)
+	if err != nil {
+		return up
+	}
+	for _, status := range combined.Statuses {
+		if status.Context == claCfg.contextName() {
+			up.lastStatusTime = status.UpdatedAt
 			break
 		}
 	}
-	return nil
+	return up
+}
+
+// claReportTable renders the unsigned-CLA-PR Markdown table for /cla-report.
+func claReportTable(claCfg Config, prs []unsignedPR) string {
+	byAuthor := map[string][]unsignedPR{}
+	var authors []string
+	for _, up := range prs {
+		author := up.issue.User.Login
+		if _, ok := byAuthor[author]; !ok {
+			authors = append(authors, author)
+		}
+		byAuthor[author] = append(byAuthor[author], up)
+	}
+	sort.Strings(authors)
+
+	var buf bytes.Buffer
+	buf.WriteString("| Author | Open PRs | Oldest unsigned CLA status |\n")
+	buf.WriteString("| --- | --- | --- |\n")
+	for _, author := range authors {
+		authorPRs := byAuthor[author]
+		oldest := authorPRs[0]
+		for _, up := range authorPRs[1:] {
+			if up.lastStatusTime.Before(oldest.lastStatusTime) {
+				oldest = up
+			}
+		}
+		age := time.Since(oldest.lastStatusTime).Round(time.Hour)
+		fmt.Fprintf(&buf, "| @%s | %d | #%d (%s since last CLA status update) |\n", author, len(authorPRs), oldest.issue.Number, age)
+	}
+	if claCfg.SignURL != "" {
+		fmt.Fprintf(&buf, "\nSign the CLA at %s.\n", claCfg.SignURL)
+	}
+	return buf.String()
 }
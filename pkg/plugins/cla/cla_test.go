@@ -0,0 +1,395 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cla
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"sigs.k8s.io/prow/pkg/github"
+	"sigs.k8s.io/prow/pkg/labels"
+	"sigs.k8s.io/prow/pkg/plugins"
+)
+
+// fakeClient is a minimal gitHubClient test double. Only the methods exercised by a given test
+// need their backing maps populated.
+type fakeClient struct {
+	comments       map[int][]github.IssueComment
+	createErr      error
+	createdCount   int
+	labels         map[int]map[string]bool
+	addLabelErr    error
+	removeLabelErr error
+
+	issues       []github.Issue
+	findIssuesFn func(query, sort string, asc bool) ([]github.Issue, error)
+	prs          map[int]*github.PullRequest
+	commits      map[int][]github.RepositoryCommit
+	combined     map[string]*github.CombinedStatus
+
+	// combinedCalls counts GetCombinedStatus calls.
+	combinedCalls int
+}
+
+func newFakeClient() *fakeClient {
+	return &fakeClient{
+		comments: map[int][]github.IssueComment{},
+		labels:   map[int]map[string]bool{},
+		prs:      map[int]*github.PullRequest{},
+		commits:  map[int][]github.RepositoryCommit{},
+		combined: map[string]*github.CombinedStatus{},
+	}
+}
+
+func (f *fakeClient) AddLabel(owner, repo string, number int, label string) error {
+	if f.addLabelErr != nil {
+		return f.addLabelErr
+	}
+	if f.labels[number] == nil {
+		f.labels[number] = map[string]bool{}
+	}
+	f.labels[number][label] = true
+	return nil
+}
+
+func (f *fakeClient) RemoveLabel(owner, repo string, number int, label string) error {
+	if f.removeLabelErr != nil {
+		return f.removeLabelErr
+	}
+	delete(f.labels[number], label)
+	return nil
+}
+
+func (f *fakeClient) GetPullRequest(owner, repo string, number int) (*github.PullRequest, error) {
+	if pr, ok := f.prs[number]; ok {
+		return pr, nil
+	}
+	return nil, fmt.Errorf("no such PR %d", number)
+}
+
+func (f *fakeClient) FindIssues(query, sort string, asc bool) ([]github.Issue, error) {
+	if f.findIssuesFn != nil {
+		return f.findIssuesFn(query, sort, asc)
+	}
+	return f.issues, nil
+}
+
+func (f *fakeClient) GetIssueLabels(org, repo string, number int) ([]github.Label, error) {
+	var out []github.Label
+	for name := range f.labels[number] {
+		out = append(out, github.Label{Name: name})
+	}
+	return out, nil
+}
+
+func (f *fakeClient) GetCombinedStatus(org, repo, ref string) (*github.CombinedStatus, error) {
+	f.combinedCalls++
+	if cs, ok := f.combined[ref]; ok {
+		return cs, nil
+	}
+	return nil, fmt.Errorf("no combined status for %s", ref)
+}
+
+func (f *fakeClient) CreateComment(owner, repo string, number int, comment string) error {
+	if f.createErr != nil {
+		return f.createErr
+	}
+	f.createdCount++
+	f.comments[number] = append(f.comments[number], github.IssueComment{Body: comment})
+	return nil
+}
+
+func (f *fakeClient) ListIssueComments(owner, repo string, number int) ([]github.IssueComment, error) {
+	return f.comments[number], nil
+}
+
+func (f *fakeClient) IsMember(org, user string) (bool, error) {
+	return false, fmt.Errorf("not implemented")
+}
+
+func (f *fakeClient) ListPullRequestCommits(org, repo string, number int) ([]github.RepositoryCommit, error) {
+	return f.commits[number], nil
+}
+
+func testLogger() *logrus.Entry {
+	return logrus.NewEntry(logrus.New())
+}
+
+func TestPostNotSignedComment(t *testing.T) {
+	cases := []struct {
+		name          string
+		claCfg        Config
+		existing      []github.IssueComment
+		wantPosted    bool
+		wantMentions  string
+	}{
+		{
+			name:         "first failure posts a comment mentioning the author",
+			claCfg:       Config{},
+			wantPosted:   true,
+			wantMentions: "@alice",
+		},
+		{
+			name:   "already-posted comment for this SHA is not duplicated",
+			claCfg: Config{},
+			existing: []github.IssueComment{
+				{Body: "Hi @alice, thanks for your pull request!\n" + notSignedMarker("sha1")},
+			},
+			wantPosted: false,
+		},
+		{
+			name:       "SuppressCommentOnLabelChange disables the comment entirely",
+			claCfg:     Config{plugins.CLA{SuppressCommentOnLabelChange: true}},
+			wantPosted: false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			fc := newFakeClient()
+			fc.comments[5] = tc.existing
+
+			postNotSignedComment(fc, testLogger(), tc.claCfg, "org", "repo", 5, "alice", "sha1")
+
+			if tc.wantPosted && fc.createdCount != 1 {
+				t.Fatalf("expected a comment to be posted, got %d", fc.createdCount)
+			}
+			if !tc.wantPosted && fc.createdCount != 0 {
+				t.Fatalf("expected no comment to be posted, got %d", fc.createdCount)
+			}
+			if tc.wantMentions != "" {
+				posted := fc.comments[5][len(fc.comments[5])-1].Body
+				if !strings.Contains(posted, tc.wantMentions) {
+					t.Fatalf("expected comment to mention %s, got: %s", tc.wantMentions, posted)
+				}
+			}
+		})
+	}
+}
+
+func TestPostNotSignedComment_RepeatedFailureDoesNotReComment(t *testing.T) {
+	fc := newFakeClient()
+	claCfg := Config{}
+
+	postNotSignedComment(fc, testLogger(), claCfg, "org", "repo", 5, "alice", "sha1")
+	if fc.createdCount != 1 {
+		t.Fatalf("expected one comment after first failure, got %d", fc.createdCount)
+	}
+
+	// Same HEAD SHA reported failing again (e.g. a retried webhook): no second comment.
+	postNotSignedComment(fc, testLogger(), claCfg, "org", "repo", 5, "alice", "sha1")
+	if fc.createdCount != 1 {
+		t.Fatalf("expected no additional comment for a repeated failure at the same SHA, got %d", fc.createdCount)
+	}
+
+	// A new commit (new SHA) failing again is a new transition and gets its own comment.
+	postNotSignedComment(fc, testLogger(), claCfg, "org", "repo", 5, "alice", "sha2")
+	if fc.createdCount != 2 {
+		t.Fatalf("expected a new comment for a new HEAD SHA, got %d", fc.createdCount)
+	}
+}
+
+func TestClaReportTable(t *testing.T) {
+	now := time.Now()
+	prs := []unsignedPR{
+		// alice has two unsigned PRs; the table should report the one whose CLA status is
+		// older (#1), not the one that was opened first.
+		{issue: issueFor(t, "alice", 1), lastStatusTime: now.Add(-72 * time.Hour)},
+		{issue: issueFor(t, "alice", 2), lastStatusTime: now.Add(-1 * time.Hour)},
+		{issue: issueFor(t, "bob", 3), lastStatusTime: now.Add(-5 * time.Hour)},
+	}
+
+	table := claReportTable(Config{}, prs)
+
+	if !strings.Contains(table, "| @alice | 2 | #1 (") {
+		t.Fatalf("expected alice's row to report the PR with the oldest CLA status (#1), got:\n%s", table)
+	}
+	if !strings.Contains(table, "| @bob | 1 | #3 (") {
+		t.Fatalf("expected bob's row, got:\n%s", table)
+	}
+	if strings.Index(table, "@alice") > strings.Index(table, "@bob") {
+		t.Fatalf("expected authors to be sorted alphabetically, got:\n%s", table)
+	}
+}
+
+func TestClaReportTable_SignURL(t *testing.T) {
+	prs := []unsignedPR{{issue: issueFor(t, "alice", 1), lastStatusTime: time.Now()}}
+
+	table := claReportTable(Config{plugins.CLA{SignURL: "https://example.com/sign"}}, prs)
+	if !strings.Contains(table, "https://example.com/sign") {
+		t.Fatalf("expected the sign URL to be linked, got:\n%s", table)
+	}
+}
+
+func issueFor(t *testing.T, author string, number int) github.Issue {
+	t.Helper()
+	return github.Issue{
+		Number: number,
+		User:   github.User{Login: author},
+	}
+}
+
+// TestHandle_ClaOrDco covers the cla-or-dco interaction: a DCO-context status event must still
+// reconcile DCO even though it isn't the CLA context, and an up-to-date CLA label must not
+// short-circuit DCO reconciliation.
+func TestHandle_ClaOrDco(t *testing.T) {
+	pr := &github.PullRequest{Number: 7, Head: github.PullRequestBranch{SHA: "sha1"}, User: github.User{Login: "alice"}}
+	unsignedCommit := commit("sha1", "alice@example.com", "no trailer", 1)
+
+	t.Run("DCO-context status event reconciles DCO even though it isn't the CLA context", func(t *testing.T) {
+		fc := newFakeClient()
+		fc.issues = []github.Issue{{Number: 7, User: github.User{Login: "alice"}}}
+		fc.prs[7] = pr
+		fc.commits[7] = []github.RepositoryCommit{unsignedCommit}
+
+		pc := &plugins.Configuration{CLA: []plugins.CLA{{Mode: "cla-or-dco", DCOContextName: "dco/check", Orgs: []string{"org"}}}}
+		se := github.StatusEvent{
+			Context: "dco/check",
+			State:   github.StatusFailure,
+			SHA:     "sha1",
+			Repo:    github.Repo{Owner: github.User{Login: "org"}, Name: "repo"},
+		}
+
+		if err := handle(fc, testLogger(), pc, se); err != nil {
+			t.Fatalf("handle() returned error: %v", err)
+		}
+		if !fc.labels[7][dcoSignoffNoLabel] {
+			t.Fatalf("expected %s to be applied, got labels %v", dcoSignoffNoLabel, fc.labels[7])
+		}
+	})
+
+	t.Run("up-to-date CLA label does not block DCO reconciliation", func(t *testing.T) {
+		fc := newFakeClient()
+		fc.issues = []github.Issue{{Number: 7, User: github.User{Login: "alice"}, Labels: []github.Label{{Name: labels.ClaYes}}}}
+		fc.prs[7] = pr
+		fc.commits[7] = []github.RepositoryCommit{unsignedCommit}
+		fc.labels[7] = map[string]bool{labels.ClaYes: true}
+
+		pc := &plugins.Configuration{CLA: []plugins.CLA{{Mode: "cla-or-dco", Orgs: []string{"org"}}}}
+		se := github.StatusEvent{
+			Context: claContextName,
+			State:   github.StatusSuccess,
+			SHA:     "sha1",
+			Repo:    github.Repo{Owner: github.User{Login: "org"}, Name: "repo"},
+		}
+
+		if err := handle(fc, testLogger(), pc, se); err != nil {
+			t.Fatalf("handle() returned error: %v", err)
+		}
+		if !fc.labels[7][dcoSignoffNoLabel] {
+			t.Fatalf("expected %s to still be applied even though %s was already up to date, got labels %v", dcoSignoffNoLabel, labels.ClaYes, fc.labels[7])
+		}
+	})
+}
+
+// pagedFindIssues returns a fake FindIssues backed by all, honoring a "created:>=<RFC3339>"
+// cursor appended to the query the way findUnsignedPRs advances between pages, and capping each
+// response at searchPageSize the way the real search API would.
+func pagedFindIssues(t *testing.T, all []github.Issue, calls *int) func(query, sort string, asc bool) ([]github.Issue, error) {
+	t.Helper()
+	return func(query, sort string, asc bool) ([]github.Issue, error) {
+		*calls++
+		if *calls > 10 {
+			t.Fatalf("too many FindIssues calls, pagination is not terminating")
+		}
+
+		var cursor time.Time
+		if idx := strings.Index(query, "created:>="); idx >= 0 {
+			parsed, err := time.Parse(time.RFC3339, query[idx+len("created:>="):])
+			if err != nil {
+				t.Fatalf("bad cursor timestamp in query %q: %v", query, err)
+			}
+			cursor = parsed
+		}
+
+		var page []github.Issue
+		for _, issue := range all {
+			if !issue.CreatedAt.Before(cursor) {
+				page = append(page, issue)
+				if len(page) == searchPageSize {
+					break
+				}
+			}
+		}
+		return page, nil
+	}
+}
+
+func TestFindUnsignedPRs_Pagination(t *testing.T) {
+	base := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	var all []github.Issue
+	for i := 1; i <= 150; i++ {
+		all = append(all, github.Issue{Number: i, CreatedAt: base.Add(time.Duration(i) * time.Second)})
+	}
+
+	fc := newFakeClient()
+	var calls int
+	fc.findIssuesFn = pagedFindIssues(t, all, &calls)
+
+	issues, err := findUnsignedPRs(fc, "is:pr is:open")
+	if err != nil {
+		t.Fatalf("findUnsignedPRs() returned error: %v", err)
+	}
+	if len(issues) != len(all) {
+		t.Fatalf("expected all %d issues to be collected across pages, got %d", len(all), len(issues))
+	}
+	if calls < 2 {
+		t.Fatalf("expected more than one FindIssues call to page past the %d-result limit, got %d", searchPageSize, calls)
+	}
+	seen := map[int]bool{}
+	for _, issue := range issues {
+		if seen[issue.Number] {
+			t.Fatalf("issue #%d was returned more than once", issue.Number)
+		}
+		seen[issue.Number] = true
+	}
+}
+
+// TestFindUnsignedPRs_SameSecondTieTerminates covers the degenerate case the review flagged:
+// more than one page's worth of issues created in the same second. The created:>= cursor can't
+// distinguish between them, so the second page is identical to the first; the seen/added==0
+// fallback must stop the loop there rather than spin forever, even though it means PRs beyond
+// the page boundary in that same second are left uncollected.
+func TestFindUnsignedPRs_SameSecondTieTerminates(t *testing.T) {
+	tied := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	var all []github.Issue
+	for i := 1; i <= 120; i++ {
+		all = append(all, github.Issue{Number: i, CreatedAt: tied})
+	}
+	for i := 121; i <= 150; i++ {
+		all = append(all, github.Issue{Number: i, CreatedAt: tied.Add(time.Hour)})
+	}
+
+	fc := newFakeClient()
+	var calls int
+	fc.findIssuesFn = pagedFindIssues(t, all, &calls)
+
+	issues, err := findUnsignedPRs(fc, "is:pr is:open")
+	if err != nil {
+		t.Fatalf("findUnsignedPRs() returned error: %v", err)
+	}
+	if len(issues) != searchPageSize {
+		t.Fatalf("expected pagination to stop after the first full page of same-second ties (%d issues), got %d", searchPageSize, len(issues))
+	}
+	if calls != 2 {
+		t.Fatalf("expected exactly 2 FindIssues calls (page, then a no-progress repeat that stops the loop), got %d", calls)
+	}
+}
@@ -0,0 +1,154 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cla
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+
+	"sigs.k8s.io/prow/pkg/github"
+)
+
+const (
+	// dcoSignoffYesLabel is applied when every non-merge commit in a PR carries a
+	// Signed-off-by trailer matching its author, under Config.Mode "dco"/"cla-or-dco".
+	dcoSignoffYesLabel = "dco-signoff: yes"
+	// dcoSignoffNoLabel is applied when one or more commits are missing a matching
+	// Signed-off-by trailer.
+	dcoSignoffNoLabel = "dco-signoff: no"
+)
+
+var signedOffByRe = regexp.MustCompile(`(?m)^Signed-off-by:\s*(.+?)\s*<([^<>]+)>\s*$`)
+
+// commitIsSignedOff reports whether rc carries a Signed-off-by trailer whose email matches the
+// commit author's email. Commits may have more than one trailer (e.g. co-authored commits); any
+// matching trailer is sufficient.
+func commitIsSignedOff(rc github.RepositoryCommit) bool {
+	if rc.Commit.Author.Email == "" {
+		return false
+	}
+	authorEmail := strings.ToLower(strings.TrimSpace(rc.Commit.Author.Email))
+	for _, match := range signedOffByRe.FindAllStringSubmatch(rc.Commit.Message, -1) {
+		if strings.ToLower(strings.TrimSpace(match[2])) == authorEmail {
+			return true
+		}
+	}
+	return false
+}
+
+// dcoMissingSignoffs returns the SHAs of every non-merge commit that is missing a Signed-off-by
+// trailer matching its author. Merge commits are skipped since they carry no authorship signal
+// of their own.
+func dcoMissingSignoffs(commits []github.RepositoryCommit) []string {
+	var missing []string
+	for _, rc := range commits {
+		if len(rc.Parents) > 1 {
+			continue
+		}
+		if !commitIsSignedOff(rc) {
+			missing = append(missing, rc.SHA)
+		}
+	}
+	return missing
+}
+
+func hasLabelNamed(issueLabels []github.Label, name string) bool {
+	for _, l := range issueLabels {
+		if l.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// reconcileDCO lists number's commits and applies the dco-signoff:yes/no label based on whether
+// every non-merge commit is signed off, posting a guidance comment on the commits missing a
+// trailer when it first transitions to dco-signoff:no.
+func reconcileDCO(gc gitHubClient, log *logrus.Entry, org, repo string, number int, author string) error {
+	commits, err := gc.ListPullRequestCommits(org, repo, number)
+	if err != nil {
+		return fmt.Errorf("could not list commits: %w", err)
+	}
+	missing := dcoMissingSignoffs(commits)
+
+	issueLabels, err := gc.GetIssueLabels(org, repo, number)
+	if err != nil {
+		return fmt.Errorf("could not get labels: %w", err)
+	}
+	hasYes := hasLabelNamed(issueLabels, dcoSignoffYesLabel)
+	hasNo := hasLabelNamed(issueLabels, dcoSignoffNoLabel)
+
+	if len(missing) == 0 {
+		if hasNo {
+			if err := gc.RemoveLabel(org, repo, number, dcoSignoffNoLabel); err != nil {
+				log.WithError(err).Warningf("Could not remove %s label.", dcoSignoffNoLabel)
+			}
+		}
+		if !hasYes {
+			if err := gc.AddLabel(org, repo, number, dcoSignoffYesLabel); err != nil {
+				return fmt.Errorf("could not add %s label: %w", dcoSignoffYesLabel, err)
+			}
+		}
+		return nil
+	}
+
+	if hasYes {
+		if err := gc.RemoveLabel(org, repo, number, dcoSignoffYesLabel); err != nil {
+			log.WithError(err).Warningf("Could not remove %s label.", dcoSignoffYesLabel)
+		}
+	}
+	if !hasNo {
+		if err := gc.AddLabel(org, repo, number, dcoSignoffNoLabel); err != nil {
+			return fmt.Errorf("could not add %s label: %w", dcoSignoffNoLabel, err)
+		}
+		postMissingSignoffComment(gc, log, org, repo, number, author, missing)
+	}
+	return nil
+}
+
+// postMissingSignoffComment lists the commits missing a sign-off and how to fix them, deduping
+// against a hidden marker so we don't repeat ourselves for the same set of commits.
+func postMissingSignoffComment(gc gitHubClient, log *logrus.Entry, org, repo string, number int, author string, missing []string) {
+	marker := fmt.Sprintf("<!-- dco-missing-signoff:%s -->", strings.Join(missing, ","))
+	comments, err := gc.ListIssueComments(org, repo, number)
+	if err != nil {
+		log.WithError(err).Warning("Could not list comments to dedup DCO sign-off comment.")
+	} else {
+		for _, comment := range comments {
+			if strings.Contains(comment.Body, marker) {
+				return
+			}
+		}
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "Hi @%s, thanks for the pull request!\n\n", author)
+	buf.WriteString("The following commits are missing a `Signed-off-by` trailer matching their author, as required by the Developer Certificate of Origin:\n\n")
+	for _, sha := range missing {
+		fmt.Fprintf(&buf, "- %s\n", sha)
+	}
+	buf.WriteString("\nYou can add the missing trailers and force-push with:\n\n```\ngit rebase --signoff <commit-before-your-first-change>\ngit push --force-with-lease\n```\n")
+	buf.WriteString(marker)
+
+	if err := gc.CreateComment(org, repo, number, buf.String()); err != nil {
+		log.WithError(err).Warning("Could not post DCO sign-off comment.")
+	}
+}
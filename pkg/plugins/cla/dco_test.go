@@ -0,0 +1,96 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cla
+
+import (
+	"testing"
+
+	"sigs.k8s.io/prow/pkg/github"
+)
+
+func commit(sha, authorEmail, message string, parents int) github.RepositoryCommit {
+	return github.RepositoryCommit{
+		SHA: sha,
+		Commit: github.Commit{
+			Author:  github.CommitAuthor{Email: authorEmail},
+			Message: message,
+		},
+		Parents: make([]github.Commit, parents),
+	}
+}
+
+func TestCommitIsSignedOff(t *testing.T) {
+	cases := []struct {
+		name   string
+		rc     github.RepositoryCommit
+		signed bool
+	}{
+		{
+			name:   "matching trailer",
+			rc:     commit("sha1", "alice@example.com", "fix things\n\nSigned-off-by: Alice <alice@example.com>", 1),
+			signed: true,
+		},
+		{
+			name:   "trailer email differs in case only, still matches",
+			rc:     commit("sha2", "Alice@Example.com", "fix things\n\nSigned-off-by: Alice <alice@EXAMPLE.com>", 1),
+			signed: true,
+		},
+		{
+			name:   "no trailer at all",
+			rc:     commit("sha3", "alice@example.com", "fix things", 1),
+			signed: false,
+		},
+		{
+			name:   "trailer present but email belongs to someone else",
+			rc:     commit("sha4", "alice@example.com", "fix things\n\nSigned-off-by: Mallory <mallory@example.com>", 1),
+			signed: false,
+		},
+		{
+			name:   "one of several trailers matches (co-authored commit)",
+			rc:     commit("sha5", "alice@example.com", "fix things\n\nSigned-off-by: Bob <bob@example.com>\nSigned-off-by: Alice <alice@example.com>", 1),
+			signed: true,
+		},
+		{
+			name:   "empty author email never matches",
+			rc:     commit("sha6", "", "fix things\n\nSigned-off-by: Alice <alice@example.com>", 1),
+			signed: false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := commitIsSignedOff(tc.rc); got != tc.signed {
+				t.Errorf("commitIsSignedOff() = %v, want %v", got, tc.signed)
+			}
+		})
+	}
+}
+
+func TestDcoMissingSignoffs(t *testing.T) {
+	commits := []github.RepositoryCommit{
+		commit("signed", "alice@example.com", "Signed-off-by: Alice <alice@example.com>", 1),
+		commit("unsigned", "alice@example.com", "no trailer here", 1),
+		// Merge commits (2+ parents) carry no authorship signal of their own and are skipped,
+		// even though this one has no matching trailer either.
+		commit("merge", "alice@example.com", "Merge pull request #1", 2),
+	}
+
+	missing := dcoMissingSignoffs(commits)
+	if len(missing) != 1 || missing[0] != "unsigned" {
+		t.Fatalf("expected only the unsigned non-merge commit to be reported, got %v", missing)
+	}
+}
@@ -0,0 +1,205 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cla
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/sirupsen/logrus"
+
+	"sigs.k8s.io/prow/pkg/config"
+	"sigs.k8s.io/prow/pkg/github"
+	"sigs.k8s.io/prow/pkg/labels"
+	"sigs.k8s.io/prow/pkg/plugins"
+)
+
+const (
+	defaultResyncInterval = 30 * time.Minute
+	defaultResyncWorkers  = 4
+)
+
+var (
+	resyncReconciliations = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "cla_resync_reconciliations_total",
+		Help: "Count of CLA label reconciliations performed by the periodic resync loop, by result.",
+	}, []string{"result"})
+	resyncErrors = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "cla_resync_errors_total",
+		Help: "Count of errors encountered by the periodic CLA resync loop, by stage.",
+	}, []string{"stage"})
+)
+
+func init() {
+	prometheus.MustRegister(resyncReconciliations, resyncErrors)
+}
+
+// ResyncConfig configures the periodic CLA label resync loop.
+type ResyncConfig struct {
+	// OrgRepos is the set of repos whose open PRs are periodically reconciled.
+	OrgRepos []config.OrgRepo
+	// Interval is how often each repo is resynced. Defaults to 30 minutes.
+	Interval time.Duration
+	// Jitter is a random delay added before each pass, up to this duration, so that multiple
+	// replicas don't all hit the GitHub API at the same moment.
+	Jitter time.Duration
+	// Workers bounds the number of PRs reconciled concurrently per repo. Defaults to 4.
+	Workers int
+}
+
+// Resyncer periodically reconciles cncf-cla:* labels against GetCombinedStatus, catching PRs
+// whose labels drifted out of date because a webhook was missed (outage, GitHub App
+// reinstall, etc). It reuses reconcileLabels so the resync loop can never disagree with the
+// webhook-driven handle/handleComment paths about what a label transition should be.
+type Resyncer struct {
+	gc  gitHubClient
+	log *logrus.Entry
+	pc  *plugins.Configuration
+	cfg ResyncConfig
+}
+
+// NewResyncer constructs a Resyncer, filling in defaults for unset Interval/Workers.
+func NewResyncer(gc gitHubClient, log *logrus.Entry, pc *plugins.Configuration, cfg ResyncConfig) *Resyncer {
+	if cfg.Interval <= 0 {
+		cfg.Interval = defaultResyncInterval
+	}
+	if cfg.Workers <= 0 {
+		cfg.Workers = defaultResyncWorkers
+	}
+	return &Resyncer{gc: gc, log: log, pc: pc, cfg: cfg}
+}
+
+// Run starts the resync loop and blocks until ctx is cancelled. Each pass is a bounded,
+// idempotent reconciliation, so it is safe to run a single Resyncer behind leader election
+// rather than coordinating state across replicas.
+func (r *Resyncer) Run(ctx context.Context) {
+	for {
+		if r.cfg.Jitter > 0 {
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(time.Duration(rand.Int63n(int64(r.cfg.Jitter)))):
+			}
+		}
+
+		r.runOnce(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(r.cfg.Interval):
+		}
+	}
+}
+
+// runOnce reconciles every configured repo's open PRs a single time.
+func (r *Resyncer) runOnce(ctx context.Context) {
+	for _, orgRepo := range r.cfg.OrgRepos {
+		issues, err := r.gc.FindIssues(fmt.Sprintf("repo:%s/%s is:pr is:open", orgRepo.Org, orgRepo.Repo), "", false)
+		if err != nil {
+			resyncErrors.WithLabelValues("search").Inc()
+			r.log.WithError(err).WithFields(logrus.Fields{"org": orgRepo.Org, "repo": orgRepo.Repo}).Warning("CLA resync: could not search open PRs.")
+			continue
+		}
+
+		sem := make(chan struct{}, r.cfg.Workers)
+		var wg sync.WaitGroup
+		for _, issue := range issues {
+			if ctx.Err() != nil {
+				break
+			}
+
+			issue := issue
+			wg.Add(1)
+			sem <- struct{}{}
+			go func() {
+				defer wg.Done()
+				defer func() { <-sem }()
+				r.reconcileIssue(orgRepo, issue)
+			}()
+		}
+		wg.Wait()
+	}
+}
+
+// reconcileIssue resyncs issue's labels against its current combined status (cncf-cla:*) and/or
+// its commits' DCO sign-off (dco-signoff:*), mirroring which signal(s) handle checks for a live
+// status event, so the resync loop can never disagree with the webhook-driven path about what a
+// cla-or-dco repo's labels should be.
+func (r *Resyncer) reconcileIssue(orgRepo config.OrgRepo, issue github.Issue) {
+	org, repo := orgRepo.Org, orgRepo.Repo
+	l := r.log.WithFields(logrus.Fields{"org": org, "repo": repo, "pr": issue.Number})
+
+	pr, err := r.gc.GetPullRequest(org, repo, issue.Number)
+	if err != nil {
+		resyncErrors.WithLabelValues("get-pr").Inc()
+		l.WithError(err).Warning("CLA resync: could not fetch PR.")
+		return
+	}
+
+	claCfg := claConfigFor(r.pc, org, repo)
+	ok := true
+
+	if claCfg.usesCLA() {
+		if err := r.reconcileIssueCLA(l, claCfg, org, repo, issue, pr); err != nil {
+			l.WithError(err).Warning("CLA resync: could not reconcile CLA labels.")
+			ok = false
+		}
+	}
+	if claCfg.usesDCO() {
+		if err := reconcileDCO(r.gc, l, org, repo, issue.Number, pr.User.Login); err != nil {
+			resyncErrors.WithLabelValues("reconcile-dco").Inc()
+			l.WithError(err).Warning("CLA resync: could not reconcile DCO sign-off.")
+			ok = false
+		}
+	}
+
+	if ok {
+		resyncReconciliations.WithLabelValues("ok").Inc()
+	}
+}
+
+// reconcileIssueCLA resyncs issue's cncf-cla:* labels against its combined status.
+func (r *Resyncer) reconcileIssueCLA(l *logrus.Entry, claCfg Config, org, repo string, issue github.Issue, pr *github.PullRequest) error {
+	combined, err := r.gc.GetCombinedStatus(org, repo, pr.Head.SHA)
+	if err != nil {
+		resyncErrors.WithLabelValues("get-status").Inc()
+		return fmt.Errorf("could not fetch combined status: %w", err)
+	}
+
+	var state string
+	for _, status := range combined.Statuses {
+		if status.Context == claCfg.contextName() {
+			state = status.State
+			break
+		}
+	}
+	if state == "" {
+		// No report from the configured CLA provider yet, nothing to reconcile.
+		return nil
+	}
+
+	if err := reconcileLabels(r.gc, l, claCfg, org, repo, issue.Number, pr.User.Login, pr.Head.SHA, issue.HasLabel(labels.ClaYes), issue.HasLabel(labels.ClaNo), state); err != nil {
+		resyncErrors.WithLabelValues("reconcile-cla").Inc()
+		return fmt.Errorf("could not reconcile labels: %w", err)
+	}
+	return nil
+}
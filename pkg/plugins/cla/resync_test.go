@@ -0,0 +1,66 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cla
+
+import (
+	"testing"
+
+	"sigs.k8s.io/prow/pkg/config"
+	"sigs.k8s.io/prow/pkg/github"
+	"sigs.k8s.io/prow/pkg/labels"
+	"sigs.k8s.io/prow/pkg/plugins"
+)
+
+func TestResyncer_ReconcileIssue_DCOMode(t *testing.T) {
+	fc := newFakeClient()
+	fc.prs[7] = &github.PullRequest{Number: 7, Head: github.PullRequestBranch{SHA: "sha1"}, User: github.User{Login: "alice"}}
+	fc.commits[7] = []github.RepositoryCommit{commit("sha1", "alice@example.com", "no trailer", 1)}
+
+	pc := &plugins.Configuration{CLA: []plugins.CLA{{Mode: "dco", Orgs: []string{"org"}}}}
+	r := NewResyncer(fc, testLogger(), pc, ResyncConfig{})
+
+	r.reconcileIssue(config.OrgRepo{Org: "org", Repo: "repo"}, github.Issue{Number: 7, User: github.User{Login: "alice"}})
+
+	if !fc.labels[7][dcoSignoffNoLabel] {
+		t.Fatalf("expected %s to be applied for a dco-mode repo with no sign-off, got labels %v", dcoSignoffNoLabel, fc.labels[7])
+	}
+	// A dco-mode repo has no CLA context to report on, so GetCombinedStatus should never be
+	// consulted.
+	if fc.combinedCalls != 0 {
+		t.Fatalf("expected GetCombinedStatus not to be called for a dco-mode repo, got %d calls", fc.combinedCalls)
+	}
+}
+
+func TestResyncer_ReconcileIssue_ClaOrDcoMode(t *testing.T) {
+	fc := newFakeClient()
+	fc.prs[7] = &github.PullRequest{Number: 7, Head: github.PullRequestBranch{SHA: "sha1"}, User: github.User{Login: "alice"}}
+	fc.commits[7] = []github.RepositoryCommit{commit("sha1", "alice@example.com", "no trailer", 1)}
+	fc.combined["sha1"] = &github.CombinedStatus{Statuses: []github.Status{{Context: claContextName, State: github.StatusSuccess}}}
+	fc.labels[7] = map[string]bool{labels.ClaYes: true}
+
+	pc := &plugins.Configuration{CLA: []plugins.CLA{{Mode: "cla-or-dco", Orgs: []string{"org"}}}}
+	r := NewResyncer(fc, testLogger(), pc, ResyncConfig{})
+
+	r.reconcileIssue(config.OrgRepo{Org: "org", Repo: "repo"}, github.Issue{Number: 7, User: github.User{Login: "alice"}, Labels: []github.Label{{Name: labels.ClaYes}}})
+
+	if !fc.labels[7][labels.ClaYes] {
+		t.Fatalf("expected %s to remain set since the CLA status is up to date, got labels %v", labels.ClaYes, fc.labels[7])
+	}
+	if !fc.labels[7][dcoSignoffNoLabel] {
+		t.Fatalf("expected %s to be applied even though the CLA label was already up to date, got labels %v", dcoSignoffNoLabel, fc.labels[7])
+	}
+}
@@ -0,0 +1,102 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package plugins defines the registries and shared configuration that individual Prow plugins
+// (pkg/plugins/*) register themselves against.
+package plugins
+
+import (
+	"github.com/sirupsen/logrus"
+
+	"sigs.k8s.io/prow/pkg/config"
+	"sigs.k8s.io/prow/pkg/github"
+	"sigs.k8s.io/prow/pkg/pluginhelp"
+)
+
+// Configuration holds the configuration for all Prow plugins.
+type Configuration struct {
+	// CLA configures the cla plugin's CLA provider(s), one entry per org/repo selector. See
+	// pkg/plugins/cla for the entry fields and matching semantics.
+	CLA []CLA `json:"cla,omitempty"`
+}
+
+// CLA is a single CLA (or DCO) provider configuration entry for the cla plugin.
+type CLA struct {
+	// Orgs is the list of GitHub organizations this entry applies to.
+	Orgs []string `json:"orgs,omitempty"`
+	// Repos is the list of "org/repo" this entry applies to. Repos takes precedence over Orgs.
+	Repos []string `json:"repos,omitempty"`
+	// ContextName is the GitHub status context that reports CLA signature state. Defaults to
+	// "EasyCLA" when unset.
+	ContextName string `json:"context_name,omitempty"`
+	// SignURL, if set, is linked from the CLA-not-signed guidance comment.
+	SignURL string `json:"sign_url,omitempty"`
+	// SuccessStates overrides the set of github.Status states treated as "signed".
+	// Defaults to []string{"success"}.
+	SuccessStates []string `json:"success_states,omitempty"`
+	// FailureStates overrides the set of github.Status states treated as "not signed".
+	// Defaults to []string{"failure", "error"}.
+	FailureStates []string `json:"failure_states,omitempty"`
+	// CommentTemplate is the message posted when a PR transitions to cncf-cla:no. It is
+	// rendered with text/template and has {{.Author}}, {{.SignURL}}, {{.Org}}, and {{.Repo}}
+	// available. Defaults to a generic CLA-not-signed message mentioning the author.
+	CommentTemplate string `json:"comment_template,omitempty"`
+	// SuppressCommentOnLabelChange disables the CLA-not-signed guidance comment.
+	SuppressCommentOnLabelChange bool `json:"suppress_comment_on_label_change,omitempty"`
+	// Mode selects the signal(s) this entry accepts as proof of contribution agreement:
+	//   - "" or "cla" (default): require the configured CLA provider's status context.
+	//   - "dco": require a Signed-off-by trailer on every non-merge commit instead of a CLA.
+	//   - "cla-or-dco": accept either signal.
+	Mode string `json:"mode,omitempty"`
+	// DCOContextName, when set, restricts DCO reconciliation on status events to this status
+	// context. Only consulted when Mode is "dco" or "cla-or-dco".
+	DCOContextName string `json:"dco_context_name,omitempty"`
+}
+
+// Agent provides plugins with access to a GitHub client, a logger, and the resolved plugin
+// configuration for the event they're handling.
+type Agent struct {
+	GitHubClient github.Client
+	Logger       *logrus.Entry
+	PluginConfig *Configuration
+}
+
+// StatusEventHandler handles a GitHub status event.
+type StatusEventHandler func(Agent, github.StatusEvent) error
+
+// GenericCommentHandler handles a GitHub generic comment event (issue/PR comment or review).
+type GenericCommentHandler func(Agent, github.GenericCommentEvent) error
+
+// HelpProvider describes a plugin's configuration and commands for `/help`.
+type HelpProvider func(*Configuration, []config.OrgRepo) (*pluginhelp.PluginHelp, error)
+
+var (
+	statusEventHandlers  = map[string]StatusEventHandler{}
+	commentEventHandlers = map[string]GenericCommentHandler{}
+	helpProviders        = map[string]HelpProvider{}
+)
+
+// RegisterStatusEventHandler registers a plugin's status event handler under name.
+func RegisterStatusEventHandler(name string, fn StatusEventHandler, help HelpProvider) {
+	statusEventHandlers[name] = fn
+	helpProviders[name] = help
+}
+
+// RegisterGenericCommentHandler registers a plugin's generic comment handler under name.
+func RegisterGenericCommentHandler(name string, fn GenericCommentHandler, help HelpProvider) {
+	commentEventHandlers[name] = fn
+	helpProviders[name] = help
+}